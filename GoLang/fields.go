@@ -0,0 +1,109 @@
+package main
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/Revive-Home-Brands/Innergy-API-examples/golang/innergy/workorders"
+)
+
+// projectFields parses a comma-separated path expression (e.g.
+// "Id,Number,ProjectManager.FullName,CustomFields[Name=PO Number].Value")
+// and returns one projected object per work order, keyed by the
+// requested path, so callers can pull specific fields without
+// post-processing the full response with jq.
+func projectFields(workOrders []workorders.WorkOrder, fields string) ([]map[string]interface{}, error) {
+	paths := strings.Split(fields, ",")
+	for i := range paths {
+		paths[i] = strings.TrimSpace(paths[i])
+	}
+
+	projected := make([]map[string]interface{}, len(workOrders))
+	for i, wo := range workOrders {
+		raw, err := json.Marshal(wo)
+		if err != nil {
+			return nil, err
+		}
+		var generic map[string]interface{}
+		if err := json.Unmarshal(raw, &generic); err != nil {
+			return nil, err
+		}
+
+		row := make(map[string]interface{}, len(paths))
+		for _, path := range paths {
+			row[path] = evaluatePath(generic, path)
+		}
+		projected[i] = row
+	}
+	return projected, nil
+}
+
+// evaluatePath walks a dotted path through data, supporting array
+// indexing (e.g. Assignees[0]) and predicate-based filtering on slices
+// of objects (e.g. CustomFields[Name=PO Number]). It returns nil if any
+// step fails to resolve.
+func evaluatePath(data interface{}, path string) interface{} {
+	current := data
+	for _, step := range strings.Split(path, ".") {
+		if current == nil {
+			return nil
+		}
+		field, bracket := splitStep(step)
+
+		if field != "" {
+			m, ok := current.(map[string]interface{})
+			if !ok {
+				return nil
+			}
+			current = m[field]
+		}
+
+		if bracket != "" {
+			current = applyBracket(current, bracket)
+		}
+	}
+	return current
+}
+
+// splitStep splits a path segment like "CustomFields[Name=PO Number]"
+// into its field name and bracket expression.
+func splitStep(step string) (field, bracket string) {
+	open := strings.IndexByte(step, '[')
+	if open == -1 || !strings.HasSuffix(step, "]") {
+		return step, ""
+	}
+	return step[:open], step[open+1 : len(step)-1]
+}
+
+// applyBracket resolves a bracket expression against a slice: either a
+// numeric index ("0") or a "Key=Value" predicate matched against each
+// element's field.
+func applyBracket(value interface{}, bracket string) interface{} {
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	if idx, err := strconv.Atoi(bracket); err == nil {
+		if idx < 0 || idx >= len(items) {
+			return nil
+		}
+		return items[idx]
+	}
+
+	key, want, found := strings.Cut(bracket, "=")
+	if !found {
+		return nil
+	}
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if got, ok := m[key].(string); ok && got == want {
+			return m
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package main
+
+import "testing"
+
+func TestSplitStep(t *testing.T) {
+	cases := []struct {
+		step        string
+		wantField   string
+		wantBracket string
+	}{
+		{"Id", "Id", ""},
+		{"Assignees[0]", "Assignees", "0"},
+		{"CustomFields[Name=PO Number]", "CustomFields", "Name=PO Number"},
+		{"Tags[", "Tags[", ""},
+	}
+	for _, c := range cases {
+		field, bracket := splitStep(c.step)
+		if field != c.wantField || bracket != c.wantBracket {
+			t.Errorf("splitStep(%q) = (%q, %q), want (%q, %q)", c.step, field, bracket, c.wantField, c.wantBracket)
+		}
+	}
+}
+
+func TestApplyBracketIndex(t *testing.T) {
+	items := []interface{}{"a", "b", "c"}
+
+	if got := applyBracket(items, "1"); got != "b" {
+		t.Errorf("applyBracket index 1 = %v, want b", got)
+	}
+	if got := applyBracket(items, "5"); got != nil {
+		t.Errorf("applyBracket out-of-range index = %v, want nil", got)
+	}
+	if got := applyBracket(items, "-1"); got != nil {
+		t.Errorf("applyBracket negative index = %v, want nil", got)
+	}
+	if got := applyBracket("not a slice", "0"); got != nil {
+		t.Errorf("applyBracket on non-slice = %v, want nil", got)
+	}
+}
+
+func TestApplyBracketPredicate(t *testing.T) {
+	items := []interface{}{
+		map[string]interface{}{"Name": "PO Number", "Value": "12345"},
+		map[string]interface{}{"Name": "Other", "Value": "x"},
+	}
+
+	got := applyBracket(items, "Name=PO Number")
+	m, ok := got.(map[string]interface{})
+	if !ok || m["Value"] != "12345" {
+		t.Errorf("applyBracket predicate match = %v, want map with Value 12345", got)
+	}
+
+	if got := applyBracket(items, "Name=Missing"); got != nil {
+		t.Errorf("applyBracket predicate miss = %v, want nil", got)
+	}
+	if got := applyBracket(items, "NoEquals"); got != nil {
+		t.Errorf("applyBracket malformed predicate = %v, want nil", got)
+	}
+}
+
+func TestEvaluatePath(t *testing.T) {
+	data := map[string]interface{}{
+		"Id": "wo-1",
+		"ProjectManager": map[string]interface{}{
+			"FullName": "Jane Doe",
+		},
+		"CustomFields": []interface{}{
+			map[string]interface{}{"Name": "PO Number", "Value": "12345"},
+		},
+	}
+
+	if got := evaluatePath(data, "Id"); got != "wo-1" {
+		t.Errorf("evaluatePath(Id) = %v, want wo-1", got)
+	}
+	if got := evaluatePath(data, "ProjectManager.FullName"); got != "Jane Doe" {
+		t.Errorf("evaluatePath(ProjectManager.FullName) = %v, want Jane Doe", got)
+	}
+	if got := evaluatePath(data, `CustomFields[Name=PO Number].Value`); got != "12345" {
+		t.Errorf("evaluatePath(CustomFields[Name=PO Number].Value) = %v, want 12345", got)
+	}
+	if got := evaluatePath(data, "Missing.Field"); got != nil {
+		t.Errorf("evaluatePath(Missing.Field) = %v, want nil", got)
+	}
+	if got := evaluatePath(data, "CustomFields[Name=Nope].Value"); got != nil {
+		t.Errorf("evaluatePath with no predicate match = %v, want nil", got)
+	}
+}
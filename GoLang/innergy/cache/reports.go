@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+)
+
+// OpenByFacility is one row of the open-work-orders-by-facility report.
+type OpenByFacility struct {
+	Facility string `json:"facility"`
+	Count    int    `json:"count"`
+}
+
+// OpenWorkOrdersByFacility returns counts of non-closed work orders
+// grouped by facility, busiest first.
+func (s *Store) OpenWorkOrdersByFacility(ctx context.Context) ([]OpenByFacility, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT facility, COUNT(*)
+		FROM work_orders
+		WHERE status NOT IN ('Closed', 'Completed', 'Cancelled')
+		GROUP BY facility
+		ORDER BY COUNT(*) DESC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("query open work orders by facility: %w", err)
+	}
+	defer rows.Close()
+
+	var results []OpenByFacility
+	for rows.Next() {
+		var r OpenByFacility
+		if err := rows.Scan(&r.Facility, &r.Count); err != nil {
+			return nil, err
+		}
+		results = append(results, r)
+	}
+	return results, rows.Err()
+}
+
+// MarginVarianceRow is one row of the margin-variance-above report.
+type MarginVarianceRow struct {
+	Number         string  `json:"number"`
+	Facility       string  `json:"facility"`
+	MarginVariance float64 `json:"marginVariance"`
+}
+
+// MarginVarianceAbove returns work orders whose margin variance exceeds
+// threshold, largest variance first.
+func (s *Store) MarginVarianceAbove(ctx context.Context, threshold float64) ([]MarginVarianceRow, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT number, facility, margin_variance
+		FROM work_orders
+		WHERE margin_variance > ?
+		ORDER BY margin_variance DESC
+	`, threshold)
+	if err != nil {
+		return nil, fmt.Errorf("query margin variance: %w", err)
+	}
+	defer rows.Close()
+
+	var results []MarginVarianceRow
+	for rows.Next() {
+		var r MarginVarianceRow
+		if err := rows.Scan(&r.Number, &r.Facility, &r.MarginVariance); err != nil {
+			return nil, err
+		}
+		results = append(results, r)
+	}
+	return results, rows.Err()
+}
+
+// MissingMaterialNeededDate returns the numbers of work orders with no
+// MaterialNeededDate set, which otherwise risk falling through
+// procurement planning.
+func (s *Store) MissingMaterialNeededDate(ctx context.Context) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT number FROM work_orders
+		WHERE material_needed_date IS NULL OR material_needed_date = ''
+		ORDER BY number
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("query missing material needed date: %w", err)
+	}
+	defer rows.Close()
+
+	var numbers []string
+	for rows.Next() {
+		var number string
+		if err := rows.Scan(&number); err != nil {
+			return nil, err
+		}
+		numbers = append(numbers, number)
+	}
+	return numbers, rows.Err()
+}
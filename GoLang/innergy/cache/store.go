@@ -0,0 +1,216 @@
+// Package cache persists fetched work orders to a local SQLite database
+// (via modernc.org/sqlite, no CGO required) so they can be queried
+// offline without re-hitting the Innergy API.
+package cache
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/Revive-Home-Brands/Innergy-API-examples/golang/innergy/workorders"
+)
+
+// Store is a SQLite-backed cache of work orders, normalized into a
+// work_orders table plus child tables for people, tags, custom fields,
+// and finishes.
+type Store struct {
+	db *sql.DB
+}
+
+// Open opens (creating if necessary) the SQLite database at path and
+// migrates it to the current schema.
+func Open(path string) (*Store, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("open sqlite database %s: %w", path, err)
+	}
+
+	s := &Store{db: db}
+	if err := s.migrate(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// Close closes the underlying database connection.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+const schema = `
+CREATE TABLE IF NOT EXISTS work_orders (
+	id                   TEXT PRIMARY KEY,
+	number               TEXT,
+	name                 TEXT,
+	type                 TEXT,
+	status               TEXT,
+	facility             TEXT,
+	project_number       TEXT,
+	project_name         TEXT,
+	planned_end_month    TEXT,
+	material_needed_date TEXT,
+	estimated_cost       REAL,
+	estimated_margin_pct REAL,
+	actual_cost          REAL,
+	actual_margin_pct    REAL,
+	margin_variance      REAL,
+	grand_total_price    REAL,
+	created_on           TEXT,
+	modified_on          TEXT
+);
+CREATE INDEX IF NOT EXISTS idx_work_orders_status ON work_orders(status);
+CREATE INDEX IF NOT EXISTS idx_work_orders_facility ON work_orders(facility);
+CREATE INDEX IF NOT EXISTS idx_work_orders_project_number ON work_orders(project_number);
+CREATE INDEX IF NOT EXISTS idx_work_orders_planned_end_month ON work_orders(planned_end_month);
+
+CREATE TABLE IF NOT EXISTS work_order_people (
+	work_order_id TEXT NOT NULL REFERENCES work_orders(id),
+	role          TEXT NOT NULL,
+	person_id     TEXT,
+	full_name     TEXT
+);
+CREATE INDEX IF NOT EXISTS idx_work_order_people_wo ON work_order_people(work_order_id);
+
+CREATE TABLE IF NOT EXISTS work_order_tags (
+	work_order_id TEXT NOT NULL REFERENCES work_orders(id),
+	tag           TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_work_order_tags_wo ON work_order_tags(work_order_id);
+
+CREATE TABLE IF NOT EXISTS work_order_custom_fields (
+	work_order_id TEXT NOT NULL REFERENCES work_orders(id),
+	name          TEXT NOT NULL,
+	type          INTEGER,
+	value         TEXT
+);
+CREATE INDEX IF NOT EXISTS idx_work_order_custom_fields_wo ON work_order_custom_fields(work_order_id);
+
+CREATE TABLE IF NOT EXISTS work_order_finishes (
+	work_order_id TEXT NOT NULL REFERENCES work_orders(id),
+	finish_id     TEXT,
+	name          TEXT,
+	code          TEXT,
+	number        TEXT
+);
+CREATE INDEX IF NOT EXISTS idx_work_order_finishes_wo ON work_order_finishes(work_order_id);
+`
+
+func (s *Store) migrate() error {
+	if _, err := s.db.Exec(schema); err != nil {
+		return fmt.Errorf("migrate sqlite schema: %w", err)
+	}
+	return nil
+}
+
+// Upsert inserts or updates a row per work order and replaces all of its
+// child rows (people, tags, custom fields, finishes), so the cache
+// always reflects the most recently fetched snapshot.
+func (s *Store) Upsert(ctx context.Context, items []workorders.WorkOrder) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin upsert transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, wo := range items {
+		if err := upsertOne(ctx, tx, wo); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func upsertOne(ctx context.Context, tx *sql.Tx, wo workorders.WorkOrder) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO work_orders (
+			id, number, name, type, status, facility, project_number, project_name,
+			planned_end_month, material_needed_date,
+			estimated_cost, estimated_margin_pct, actual_cost, actual_margin_pct,
+			margin_variance, grand_total_price, created_on, modified_on
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET
+			number=excluded.number, name=excluded.name, type=excluded.type,
+			status=excluded.status, facility=excluded.facility,
+			project_number=excluded.project_number, project_name=excluded.project_name,
+			planned_end_month=excluded.planned_end_month, material_needed_date=excluded.material_needed_date,
+			estimated_cost=excluded.estimated_cost, estimated_margin_pct=excluded.estimated_margin_pct,
+			actual_cost=excluded.actual_cost, actual_margin_pct=excluded.actual_margin_pct,
+			margin_variance=excluded.margin_variance, grand_total_price=excluded.grand_total_price,
+			created_on=excluded.created_on, modified_on=excluded.modified_on
+	`,
+		wo.Id, wo.Number, wo.Name, wo.Type, wo.Status, wo.Facility, wo.ProjectNumber, wo.ProjectName,
+		wo.PlannedEndMonth, wo.MaterialNeededDate,
+		wo.EstimatedCost.Value, wo.EstimatedMargin.Percentage, wo.ActualCost.Value, wo.ActualMargin.Percentage,
+		wo.MarginVariance.Value, wo.GrandTotalPrice.Value, wo.CreatedOn, wo.ModifiedOn,
+	)
+	if err != nil {
+		return fmt.Errorf("upsert work order %s: %w", wo.Id, err)
+	}
+	return replaceChildRows(ctx, tx, wo)
+}
+
+func replaceChildRows(ctx context.Context, tx *sql.Tx, wo workorders.WorkOrder) error {
+	for _, table := range []string{"work_order_people", "work_order_tags", "work_order_custom_fields", "work_order_finishes"} {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE work_order_id = ?", table), wo.Id); err != nil {
+			return fmt.Errorf("clear %s for %s: %w", table, wo.Id, err)
+		}
+	}
+
+	roles := []struct {
+		role   string
+		people []workorders.Person
+	}{
+		{"owner", []workorders.Person{wo.Owner}},
+		{"project_manager", []workorders.Person{wo.ProjectManager}},
+		{"created_by", []workorders.Person{wo.CreatedBy}},
+		{"assignee", wo.Assignees},
+		{"drafter", wo.Drafters},
+		{"engineer", wo.Engineers},
+		{"estimator", wo.Estimators},
+		{"sales_person", wo.SalesPersons},
+		{"coordinator", wo.Coordinators},
+		{"installer", wo.Installers},
+	}
+	for _, r := range roles {
+		for _, p := range r.people {
+			if p.Id == "" && p.FullName == "" {
+				continue
+			}
+			if _, err := tx.ExecContext(ctx,
+				`INSERT INTO work_order_people (work_order_id, role, person_id, full_name) VALUES (?, ?, ?, ?)`,
+				wo.Id, r.role, p.Id, p.FullName); err != nil {
+				return fmt.Errorf("insert %s for %s: %w", r.role, wo.Id, err)
+			}
+		}
+	}
+
+	for _, tag := range wo.Tags {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO work_order_tags (work_order_id, tag) VALUES (?, ?)`, wo.Id, tag); err != nil {
+			return fmt.Errorf("insert tag for %s: %w", wo.Id, err)
+		}
+	}
+
+	for _, cf := range wo.CustomFields {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO work_order_custom_fields (work_order_id, name, type, value) VALUES (?, ?, ?, ?)`,
+			wo.Id, cf.Name, cf.Type, cf.Value); err != nil {
+			return fmt.Errorf("insert custom field for %s: %w", wo.Id, err)
+		}
+	}
+
+	for _, f := range wo.Finishes {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO work_order_finishes (work_order_id, finish_id, name, code, number) VALUES (?, ?, ?, ?, ?)`,
+			wo.Id, f.Id, f.Name, f.Code, f.Number); err != nil {
+			return fmt.Errorf("insert finish for %s: %w", wo.Id, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,312 @@
+package workorders
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	defaultBaseURL  = "https://app.innergy.com/api/projectWorkOrders"
+	defaultTimeout  = 120 * time.Second
+	defaultPageSize = 100
+	maxRetries      = 5
+
+	// defaultRateLimit and defaultRateBurst throttle requests to the
+	// Innergy API so a fast tenant doesn't hammer it until it starts
+	// 429ing; they're conservative enough to be safe, not tuned for
+	// throughput.
+	defaultRateLimit = 5 // requests per second
+	defaultRateBurst = 5
+)
+
+// Doer is satisfied by *http.Client. It lets callers inject custom
+// transports (proxies, mTLS, test doubles) without the package depending
+// on http.Client directly.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client fetches work orders from the Innergy API, handling pagination,
+// filtering, retries, and rate limiting.
+type Client struct {
+	doer    Doer
+	apiKey  string
+	baseURL string
+	limiter *rate.Limiter
+}
+
+// Option configures a Client constructed by NewClient.
+type Option func(*Client)
+
+// WithDoer overrides the Doer used to execute requests, e.g. to inject a
+// test double or a client with custom middleware.
+func WithDoer(d Doer) Option {
+	return func(c *Client) { c.doer = d }
+}
+
+// WithTransport sets a custom http.RoundTripper (proxies, mTLS, etc.) on
+// the Client's default *http.Client. It has no effect if WithDoer has
+// replaced the Client's Doer with something other than an *http.Client.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *Client) {
+		if hc, ok := c.doer.(*http.Client); ok {
+			hc.Transport = rt
+		}
+	}
+}
+
+// WithRateLimit overrides the default request pacing (5 requests/second,
+// burst 5) applied between pages.
+func WithRateLimit(requestsPerSecond rate.Limit, burst int) Option {
+	return func(c *Client) { c.limiter = rate.NewLimiter(requestsPerSecond, burst) }
+}
+
+// NewClient creates a Client authenticated with the given Innergy API
+// key. By default requests share a client-wide 120 second timeout;
+// callers needing tighter, per-call deadlines should set Options.CallTimeout
+// instead of lowering this.
+func NewClient(apiKey string, opts ...Option) *Client {
+	c := &Client{
+		doer:    &http.Client{Timeout: defaultTimeout},
+		apiKey:  apiKey,
+		baseURL: defaultBaseURL,
+		limiter: rate.NewLimiter(defaultRateLimit, defaultRateBurst),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Options narrows a fetch to a subset of work orders and controls
+// pagination and incremental sync.
+type Options struct {
+	Status             string
+	Facility           string
+	PlannedStartAfter  time.Time
+	PlannedStartBefore time.Time
+
+	// UpdatedAfter restricts results to work orders modified after this
+	// time. If zero and StatePath is set, the persisted cursor for this
+	// client's API key is used instead, turning the fetch into an
+	// incremental sync.
+	UpdatedAfter time.Time
+
+	// PageSize is the number of work orders requested per page. Defaults
+	// to 100 when zero or negative.
+	PageSize int
+
+	// StatePath, when set, is the path to a local JSON file used to
+	// persist the last CreatedOn/ModifiedOn cursor seen per API key, so
+	// the next FetchAll call only pulls deltas.
+	StatePath string
+
+	// CallTimeout, when set, bounds each individual page request
+	// independently of the Client's own timeout, so a caller embedding
+	// the Client in a long-running service can enforce a tighter
+	// deadline per call without affecting the shared http.Client.
+	CallTimeout time.Duration
+}
+
+// FetchAll streams every work order matching opts over the returned
+// channel, paging through the API as results are consumed. Errors are
+// delivered on the second channel. Both channels are closed once the
+// fetch completes, fails, or ctx is done.
+func (c *Client) FetchAll(ctx context.Context, opts Options) (<-chan WorkOrder, <-chan error) {
+	out := make(chan WorkOrder)
+	errc := make(chan error, 1)
+
+	go func() {
+		defer close(out)
+		defer close(errc)
+
+		state, err := loadState(opts.StatePath)
+		if err != nil {
+			errc <- err
+			return
+		}
+		if opts.UpdatedAfter.IsZero() {
+			if cursor, ok := state.Cursors[c.apiKey]; ok {
+				opts.UpdatedAfter = cursor
+			}
+		}
+
+		take := opts.PageSize
+		if take <= 0 {
+			take = defaultPageSize
+		}
+
+		var latest time.Time
+		skip := 0
+		for {
+			page, err := c.fetchPage(ctx, opts, skip, take)
+			if err != nil {
+				errc <- err
+				return
+			}
+			if len(page) == 0 {
+				break
+			}
+			for _, wo := range page {
+				if modified, err := parseInnergyTime(wo.ModifiedOn); err == nil && modified.After(latest) {
+					latest = modified
+				}
+				select {
+				case out <- wo:
+				case <-ctx.Done():
+					errc <- ctx.Err()
+					return
+				}
+			}
+			if len(page) < take {
+				break
+			}
+			skip += take
+		}
+
+		if opts.StatePath != "" && !latest.IsZero() {
+			state.Cursors[c.apiKey] = latest
+			if err := saveState(opts.StatePath, state); err != nil {
+				errc <- err
+			}
+		}
+	}()
+
+	return out, errc
+}
+
+// fetchPage requests a single page of work orders matching opts. If
+// opts.CallTimeout is set, it bounds this one request's deadline without
+// affecting the rest of the stream.
+func (c *Client) fetchPage(ctx context.Context, opts Options, skip, take int) ([]WorkOrder, error) {
+	if opts.CallTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.CallTimeout)
+		defer cancel()
+	}
+
+	q := url.Values{}
+	q.Set("skip", strconv.Itoa(skip))
+	q.Set("take", strconv.Itoa(take))
+	if opts.Status != "" {
+		q.Set("status", opts.Status)
+	}
+	if opts.Facility != "" {
+		q.Set("facility", opts.Facility)
+	}
+	if !opts.PlannedStartAfter.IsZero() {
+		q.Set("plannedStartAfter", opts.PlannedStartAfter.Format(time.RFC3339))
+	}
+	if !opts.PlannedStartBefore.IsZero() {
+		q.Set("plannedStartBefore", opts.PlannedStartBefore.Format(time.RFC3339))
+	}
+	if !opts.UpdatedAfter.IsZero() {
+		q.Set("updatedAfter", opts.UpdatedAfter.Format(time.RFC3339))
+	}
+
+	body, err := c.doWithRetry(ctx, c.baseURL+"?"+q.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	var resp APIResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("parse work orders page: %w", err)
+	}
+	return resp.Items, nil
+}
+
+// doWithRetry performs a GET request against reqURL, pacing requests
+// through c.limiter and retrying with exponential backoff on 429 and 5xx
+// responses. A 429 response's Retry-After header, if present, is waited
+// out explicitly instead of (not in addition to) the next exponential
+// backoff, so the two waits never stack.
+func (c *Client) doWithRetry(ctx context.Context, reqURL string) ([]byte, error) {
+	var lastErr error
+	var retryAfterWait time.Duration
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if attempt > 0 {
+			wait := retryAfterWait
+			if wait == 0 {
+				wait = backoffDelay(attempt)
+			}
+			if err := sleep(ctx, wait); err != nil {
+				return nil, err
+			}
+		}
+		retryAfterWait = 0
+
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("Api-Key", c.apiKey)
+
+		resp, err := c.doer.Do(req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+			lastErr = fmt.Errorf("API returned status %d", resp.StatusCode)
+			resp.Body.Close()
+			retryAfterWait = retryAfterDelay(resp.Header.Get("Retry-After"))
+			continue
+		}
+
+		defer resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return nil, fmt.Errorf("API returned status %d", resp.StatusCode)
+		}
+		return io.ReadAll(resp.Body)
+	}
+	return nil, fmt.Errorf("giving up after %d retries: %w", maxRetries, lastErr)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	select {
+	case <-time.After(d):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// backoffDelay returns an exponential backoff duration (1s, 2s, 4s, ...)
+// with up to 50% jitter, for the given retry attempt (1-indexed).
+func backoffDelay(attempt int) time.Duration {
+	base := time.Duration(math.Pow(2, float64(attempt-1))) * time.Second
+	jitter := time.Duration(rand.Int63n(int64(base)/2 + 1))
+	return base + jitter
+}
+
+// retryAfterDelay parses a Retry-After header, which per RFC 7231 may be
+// either a number of seconds or an HTTP date.
+func retryAfterDelay(header string) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(header); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(header); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
@@ -0,0 +1,58 @@
+package workorders
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// syncState is the on-disk incremental-sync cursor file. Cursors are
+// keyed by API key so one state file can track multiple tenants.
+type syncState struct {
+	Cursors map[string]time.Time `json:"cursors"`
+}
+
+func loadState(path string) (*syncState, error) {
+	if path == "" {
+		return &syncState{Cursors: map[string]time.Time{}}, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &syncState{Cursors: map[string]time.Time{}}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read sync state: %w", err)
+	}
+
+	var state syncState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("parse sync state %s: %w", path, err)
+	}
+	if state.Cursors == nil {
+		state.Cursors = map[string]time.Time{}
+	}
+	return &state, nil
+}
+
+func saveState(path string, state *syncState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal sync state: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("write sync state %s: %w", path, err)
+	}
+	return nil
+}
+
+// parseInnergyTime parses a CreatedOn/ModifiedOn timestamp as returned by
+// the Innergy API.
+func parseInnergyTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, fmt.Errorf("empty timestamp")
+	}
+	return time.Parse(time.RFC3339, s)
+}
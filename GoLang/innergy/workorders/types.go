@@ -0,0 +1,106 @@
+// Package workorders is a client for the Innergy Work Orders API
+// (/api/projectWorkOrders). It handles pagination, server-side filtering,
+// and incremental sync so callers can pull large tenants without loading
+// the whole response into memory.
+package workorders
+
+// Person represents a user reference in the API response
+type Person struct {
+	Id       string `json:"Id"`
+	FullName string `json:"FullName"`
+}
+
+// MoneyValue represents a monetary amount
+type MoneyValue struct {
+	Value         float64 `json:"Value"`
+	OriginalValue float64 `json:"OriginalValue"`
+	CurrencyCode  string  `json:"CurrencyCode"`
+}
+
+// Margin represents margin data with cash and percentage
+type Margin struct {
+	Cash       MoneyValue `json:"Cash"`
+	Percentage float64    `json:"Percentage"`
+}
+
+// CustomField represents a custom field entry
+type CustomField struct {
+	Name  string `json:"Name"`
+	Type  int    `json:"Type"`
+	Value string `json:"Value"`
+}
+
+// Finish represents a finish option
+type Finish struct {
+	Id     string `json:"Id"`
+	Name   string `json:"Name"`
+	Code   string `json:"Code"`
+	Number string `json:"Number"`
+}
+
+// WorkOrder represents a work order from the API
+type WorkOrder struct {
+	Id                    string        `json:"Id"`
+	Number                string        `json:"Number"`
+	Name                  string        `json:"Name"`
+	Type                  string        `json:"Type"`
+	CreatedBy             Person        `json:"CreatedBy"`
+	CreatedOn             string        `json:"CreatedOn"`
+	ModifiedOn            string        `json:"ModifiedOn"`
+	Facility              string        `json:"Facility"`
+	Outsourced            bool          `json:"Outsourced"`
+	Tags                  []string      `json:"Tags"`
+	Status                string        `json:"Status"`
+	MaterialOnHandDays    int           `json:"MaterialOnHandDays"`
+	Step                  string        `json:"Step"`
+	StepIndex             int           `json:"StepIndex"`
+	StepType              string        `json:"StepType"`
+	InvoiceStatus         string        `json:"InvoiceStatus"`
+	Owner                 Person        `json:"Owner"`
+	Assignees             []Person      `json:"Assignees"`
+	Drafters              []Person      `json:"Drafters"`
+	Engineers             []Person      `json:"Engineers"`
+	Estimators            []Person      `json:"Estimators"`
+	SalesPersons          []Person      `json:"SalesPersons"`
+	Coordinators          []Person      `json:"Coordinators"`
+	Installers            []Person      `json:"Installers"`
+	ProjectManager        Person        `json:"ProjectManager"`
+	PlannedStartDate      string        `json:"PlannedStartDate"`
+	ActualStartDate       string        `json:"ActualStartDate"`
+	PlannedCriticalDate   string        `json:"PlannedCriticalDate"`
+	MaterialNeededDate    string        `json:"MaterialNeededDate"`
+	PlannedEndMonth       string        `json:"PlannedEndMonth"`
+	ActualEndDate         string        `json:"ActualEndDate"`
+	ActualEndMonth        string        `json:"ActualEndMonth"`
+	Instructions          string        `json:"Instructions"`
+	EstimatedLaborCost    MoneyValue    `json:"EstimatedLaborCost"`
+	EstimatedMaterialCost MoneyValue    `json:"EstimatedMaterialCost"`
+	EstimatedCost         MoneyValue    `json:"EstimatedCost"`
+	EstimatedHours        string        `json:"EstimatedHours"`
+	EstimatedMargin       Margin        `json:"EstimatedMargin"`
+	RemainingHours        string        `json:"RemainingHours"`
+	PlannedHours          string        `json:"PlannedHours"`
+	PlannedLaborCost      MoneyValue    `json:"PlannedLaborCost"`
+	LaborGrandTotalPrice  MoneyValue    `json:"LaborGrandTotalPrice"`
+	ActualLaborHours      string        `json:"ActualLaborHours"`
+	ActualCost            MoneyValue    `json:"ActualCost"`
+	ActualMaterialCost    MoneyValue    `json:"ActualMaterialCost"`
+	ActualLaborCost       MoneyValue    `json:"ActualLaborCost"`
+	ActualExpensesCost    MoneyValue    `json:"ActualExpensesCost"`
+	ActualMargin          Margin        `json:"ActualMargin"`
+	MarginVariance        MoneyValue    `json:"MarginVariance"`
+	GrandTotalPrice       MoneyValue    `json:"GrandTotalPrice"`
+	PreSalesTaxPrice      MoneyValue    `json:"PreSalesTaxPrice"`
+	SalesTax              MoneyValue    `json:"SalesTax"`
+	ExternalIdentifier    string        `json:"ExternalIdentifier"`
+	WorkflowName          string        `json:"WorkflowName"`
+	ProjectNumber         string        `json:"ProjectNumber"`
+	ProjectName           string        `json:"ProjectName"`
+	CustomFields          []CustomField `json:"CustomFields"`
+	Finishes              []Finish      `json:"Finishes"`
+}
+
+// APIResponse represents the raw /api/projectWorkOrders response structure
+type APIResponse struct {
+	Items []WorkOrder `json:"Items"`
+}
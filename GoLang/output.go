@@ -0,0 +1,216 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+
+	"github.com/Revive-Home-Brands/Innergy-API-examples/golang/innergy/workorders"
+)
+
+// Writer renders a set of work orders to w in a specific output format.
+type Writer interface {
+	Write(w io.Writer, workOrders []workorders.WorkOrder) error
+}
+
+// newWriter returns the Writer for the named format ("json", "ndjson",
+// "csv", or "parquet"). outputPath is only used by parquetWriter, which
+// must write directly to a seekable file.
+func newWriter(format string, listSeparator string, outputPath string) (Writer, error) {
+	switch format {
+	case "", "json":
+		return jsonWriter{}, nil
+	case "ndjson":
+		return ndjsonWriter{}, nil
+	case "csv":
+		return csvWriter{separator: listSeparator}, nil
+	case "parquet":
+		return parquetWriter{outputPath: outputPath}, nil
+	default:
+		return nil, fmt.Errorf("unsupported -format %q (want json, ndjson, csv, or parquet)", format)
+	}
+}
+
+// jsonWriter writes a single pretty-printed JSON array, matching the
+// existing Response.workOrders shape.
+type jsonWriter struct{}
+
+func (jsonWriter) Write(w io.Writer, workOrders []workorders.WorkOrder) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(workOrders)
+}
+
+// ndjsonWriter writes one compact JSON object per work order per line,
+// suitable for streaming into log pipelines.
+type ndjsonWriter struct{}
+
+func (ndjsonWriter) Write(w io.Writer, workOrders []workorders.WorkOrder) error {
+	enc := json.NewEncoder(w)
+	for _, wo := range workOrders {
+		if err := enc.Encode(wo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// csvColumns is the deterministic column order used by csvWriter.
+var csvColumns = []string{
+	"Id", "Number", "Name", "Type", "Status", "Facility",
+	"ProjectNumber", "ProjectName",
+	"Owner", "ProjectManager", "CreatedBy",
+	"CreatedOn", "ModifiedOn",
+	"PlannedStartDate", "ActualStartDate", "PlannedCriticalDate",
+	"MaterialNeededDate", "PlannedEndMonth", "ActualEndDate", "ActualEndMonth",
+	"EstimatedCost", "EstimatedMargin", "ActualCost", "ActualMargin",
+	"MarginVariance", "GrandTotalPrice",
+	"Tags", "Assignees", "Drafters", "Engineers", "Estimators",
+	"SalesPersons", "Coordinators", "Installers",
+}
+
+// csvWriter flattens nested MoneyValue/Margin/Person fields into scalar
+// columns and joins slice fields (Tags, Assignees, ...) with separator.
+type csvWriter struct {
+	separator string
+}
+
+func (c csvWriter) Write(w io.Writer, workOrders []workorders.WorkOrder) error {
+	sep := c.separator
+	if sep == "" {
+		sep = ";"
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvColumns); err != nil {
+		return err
+	}
+
+	for _, wo := range workOrders {
+		record := []string{
+			wo.Id, wo.Number, wo.Name, wo.Type, wo.Status, wo.Facility,
+			wo.ProjectNumber, wo.ProjectName,
+			personName(wo.Owner), personName(wo.ProjectManager), personName(wo.CreatedBy),
+			wo.CreatedOn, wo.ModifiedOn,
+			wo.PlannedStartDate, wo.ActualStartDate, wo.PlannedCriticalDate,
+			wo.MaterialNeededDate, wo.PlannedEndMonth, wo.ActualEndDate, wo.ActualEndMonth,
+			moneyValue(wo.EstimatedCost), marginValue(wo.EstimatedMargin), moneyValue(wo.ActualCost), marginValue(wo.ActualMargin),
+			moneyValue(wo.MarginVariance), moneyValue(wo.GrandTotalPrice),
+			strings.Join(wo.Tags, sep),
+			joinPeople(wo.Assignees, sep), joinPeople(wo.Drafters, sep), joinPeople(wo.Engineers, sep), joinPeople(wo.Estimators, sep),
+			joinPeople(wo.SalesPersons, sep), joinPeople(wo.Coordinators, sep), joinPeople(wo.Installers, sep),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func personName(p workorders.Person) string {
+	return p.FullName
+}
+
+func joinPeople(people []workorders.Person, sep string) string {
+	names := make([]string, len(people))
+	for i, p := range people {
+		names[i] = p.FullName
+	}
+	return strings.Join(names, sep)
+}
+
+func moneyValue(m workorders.MoneyValue) string {
+	return strconv.FormatFloat(m.Value, 'f', -1, 64)
+}
+
+func marginValue(m workorders.Margin) string {
+	return strconv.FormatFloat(m.Percentage, 'f', -1, 64)
+}
+
+// parquetRecord is the flat row type written to Parquet files, mirroring
+// the csvWriter column set.
+type parquetRecord struct {
+	Id                 string  `parquet:"name=id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Number             string  `parquet:"name=number, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Name               string  `parquet:"name=name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Status             string  `parquet:"name=status, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Facility           string  `parquet:"name=facility, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ProjectNumber      string  `parquet:"name=project_number, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ProjectName        string  `parquet:"name=project_name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ProjectManager     string  `parquet:"name=project_manager, type=BYTE_ARRAY, convertedtype=UTF8"`
+	CreatedOn          string  `parquet:"name=created_on, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ModifiedOn         string  `parquet:"name=modified_on, type=BYTE_ARRAY, convertedtype=UTF8"`
+	EstimatedCost      float64 `parquet:"name=estimated_cost, type=DOUBLE"`
+	EstimatedMarginPct float64 `parquet:"name=estimated_margin_pct, type=DOUBLE"`
+	ActualCost         float64 `parquet:"name=actual_cost, type=DOUBLE"`
+	ActualMarginPct    float64 `parquet:"name=actual_margin_pct, type=DOUBLE"`
+	MarginVariance     float64 `parquet:"name=margin_variance, type=DOUBLE"`
+	GrandTotalPrice    float64 `parquet:"name=grand_total_price, type=DOUBLE"`
+	Tags               string  `parquet:"name=tags, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+// parquetWriter writes rows via github.com/xitongsys/parquet-go. Unlike
+// the other Writers it requires a real, seekable file path (parquet's
+// footer is written at Close), so it ignores the io.Writer and instead
+// expects outputPath to have been set by the caller.
+type parquetWriter struct {
+	outputPath string
+}
+
+func (p parquetWriter) Write(_ io.Writer, workOrders []workorders.WorkOrder) error {
+	if p.outputPath == "" {
+		return fmt.Errorf("parquet output requires -output=<path>")
+	}
+
+	fw, err := local.NewLocalFileWriter(p.outputPath)
+	if err != nil {
+		return fmt.Errorf("open parquet file: %w", err)
+	}
+	defer fw.Close()
+
+	pw, err := writer.NewParquetWriter(fw, new(parquetRecord), 4)
+	if err != nil {
+		return fmt.Errorf("create parquet writer: %w", err)
+	}
+	pw.RowGroupSize = 128 * 1024 * 1024
+	pw.CompressionType = parquet.CompressionCodec_SNAPPY
+
+	for _, wo := range workOrders {
+		record := parquetRecord{
+			Id:                 wo.Id,
+			Number:             wo.Number,
+			Name:               wo.Name,
+			Status:             wo.Status,
+			Facility:           wo.Facility,
+			ProjectNumber:      wo.ProjectNumber,
+			ProjectName:        wo.ProjectName,
+			ProjectManager:     wo.ProjectManager.FullName,
+			CreatedOn:          wo.CreatedOn,
+			ModifiedOn:         wo.ModifiedOn,
+			EstimatedCost:      wo.EstimatedCost.Value,
+			EstimatedMarginPct: wo.EstimatedMargin.Percentage,
+			ActualCost:         wo.ActualCost.Value,
+			ActualMarginPct:    wo.ActualMargin.Percentage,
+			MarginVariance:     wo.MarginVariance.Value,
+			GrandTotalPrice:    wo.GrandTotalPrice.Value,
+			Tags:               strings.Join(wo.Tags, ";"),
+		}
+		if err := pw.Write(record); err != nil {
+			return fmt.Errorf("write parquet row: %w", err)
+		}
+	}
+
+	if err := pw.WriteStop(); err != nil {
+		return fmt.Errorf("finalize parquet file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"github.com/Revive-Home-Brands/Innergy-API-examples/golang/innergy/workorders"
+)
+
+func TestCSVWriterFlattensAndJoins(t *testing.T) {
+	wo := workorders.WorkOrder{
+		Id:     "wo-1",
+		Number: "1001",
+		Name:   "Cabinet run",
+		Status: "Active",
+		Tags:   []string{"rush", "repeat"},
+		Owner:  workorders.Person{FullName: "Jane Doe"},
+		Assignees: []workorders.Person{
+			{FullName: "Alice"},
+			{FullName: "Bob"},
+		},
+		EstimatedCost:   workorders.MoneyValue{Value: 1234.5},
+		EstimatedMargin: workorders.Margin{Percentage: 12.5},
+	}
+
+	var buf bytes.Buffer
+	w := csvWriter{separator: "|"}
+	if err := w.Write(&buf, []workorders.WorkOrder{wo}); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("parse csv output: %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2 (header + 1 record)", len(rows))
+	}
+
+	header, record := rows[0], rows[1]
+	col := func(name string) string {
+		for i, h := range header {
+			if h == name {
+				return record[i]
+			}
+		}
+		t.Fatalf("column %q not found in header %v", name, header)
+		return ""
+	}
+
+	if got := col("Owner"); got != "Jane Doe" {
+		t.Errorf("Owner column = %q, want %q", got, "Jane Doe")
+	}
+	if got := col("Assignees"); got != "Alice|Bob" {
+		t.Errorf("Assignees column = %q, want %q", got, "Alice|Bob")
+	}
+	if got := col("Tags"); got != "rush|repeat" {
+		t.Errorf("Tags column = %q, want %q", got, "rush|repeat")
+	}
+	if got := col("EstimatedCost"); got != "1234.5" {
+		t.Errorf("EstimatedCost column = %q, want %q", got, "1234.5")
+	}
+	if got := col("EstimatedMargin"); got != "12.5" {
+		t.Errorf("EstimatedMargin column = %q, want %q", got, "12.5")
+	}
+}
+
+func TestCSVWriterDefaultSeparator(t *testing.T) {
+	wo := workorders.WorkOrder{
+		Id:   "wo-1",
+		Tags: []string{"a", "b"},
+	}
+
+	var buf bytes.Buffer
+	w := csvWriter{} // separator left empty, should default to ";"
+	if err := w.Write(&buf, []workorders.WorkOrder{wo}); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	if !strings.Contains(buf.String(), "a;b") {
+		t.Errorf("output %q does not contain default-separator-joined tags %q", buf.String(), "a;b")
+	}
+}
+
+func TestCSVWriterEmptyInput(t *testing.T) {
+	var buf bytes.Buffer
+	w := csvWriter{separator: ";"}
+	if err := w.Write(&buf, nil); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("parse csv output: %v", err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("got %d rows, want 1 (header only)", len(rows))
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Revive-Home-Brands/Innergy-API-examples/golang/innergy/cache"
+	"github.com/Revive-Home-Brands/Innergy-API-examples/golang/innergy/workorders"
+)
+
+// cacheWorkOrders opens (or creates) the SQLite database at path and
+// upserts items into it.
+func cacheWorkOrders(ctx context.Context, path string, items []workorders.WorkOrder) error {
+	store, err := cache.Open(path)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	return store.Upsert(ctx, items)
+}
+
+// runQuery opens the SQLite database at cachePath and prints the named
+// report as JSON. Supported reports: "open-by-facility",
+// "margin-variance=<N>", "missing-material-date".
+func runQuery(ctx context.Context, cachePath, query string) error {
+	store, err := cache.Open(cachePath)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	var result interface{}
+	switch {
+	case query == "open-by-facility":
+		result, err = store.OpenWorkOrdersByFacility(ctx)
+	case strings.HasPrefix(query, "margin-variance="):
+		threshold, perr := strconv.ParseFloat(strings.TrimPrefix(query, "margin-variance="), 64)
+		if perr != nil {
+			return fmt.Errorf("invalid -query margin-variance threshold: %w", perr)
+		}
+		result, err = store.MarginVarianceAbove(ctx, threshold)
+	case query == "missing-material-date":
+		result, err = store.MissingMaterialNeededDate(ctx)
+	default:
+		return fmt.Errorf("unknown -query %q (want open-by-facility, margin-variance=<N>, or missing-material-date)", query)
+	}
+	if err != nil {
+		return err
+	}
+
+	output, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Revive-Home-Brands/Innergy-API-examples/golang/innergy/workorders"
+)
+
+// server re-exports Innergy work orders as a local REST API, refreshing
+// an in-memory snapshot from the Innergy API on a fixed interval so
+// callers that can't hold an Innergy API key themselves (dashboards,
+// Zapier-style workflows, internal tools) can query it instead.
+type server struct {
+	apiKey string
+	opts   workorders.Options
+
+	mu         sync.RWMutex
+	workOrders []workorders.WorkOrder
+}
+
+// runServe starts the HTTP server at addr, refreshing from the Innergy
+// API every interval, and blocks until ctx is done.
+func runServe(ctx context.Context, apiKey string, opts workorders.Options, addr string, interval time.Duration) error {
+	s := &server{apiKey: apiKey, opts: opts}
+	s.refresh(ctx)
+
+	go s.refreshLoop(ctx, interval)
+
+	httpServer := &http.Server{Addr: addr, Handler: s.handler()}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		httpServer.Shutdown(shutdownCtx)
+	}()
+
+	log.Printf("serving cached work orders on %s (refresh every %s)", addr, interval)
+	if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+func (s *server) refreshLoop(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.refresh(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *server) refresh(ctx context.Context) {
+	items, err := fetchWorkOrders(ctx, s.apiKey, s.opts)
+	if err != nil {
+		log.Printf("refresh from Innergy failed, serving stale cache: %v", err)
+		return
+	}
+
+	s.mu.Lock()
+	if s.opts.StatePath == "" {
+		s.workOrders = items
+	} else {
+		// With StatePath set, fetchWorkOrders does an incremental sync and
+		// items is only the deltas since the last refresh, so merge them
+		// into the existing snapshot instead of replacing it.
+		s.workOrders = mergeWorkOrders(s.workOrders, items)
+	}
+	s.mu.Unlock()
+}
+
+// mergeWorkOrders returns existing with each item in updates inserted or,
+// if its Id is already present, replacing the matching entry in place.
+func mergeWorkOrders(existing, updates []workorders.WorkOrder) []workorders.WorkOrder {
+	index := make(map[string]int, len(existing))
+	for i, wo := range existing {
+		index[wo.Id] = i
+	}
+
+	merged := append([]workorders.WorkOrder(nil), existing...)
+	for _, wo := range updates {
+		if i, ok := index[wo.Id]; ok {
+			merged[i] = wo
+			continue
+		}
+		index[wo.Id] = len(merged)
+		merged = append(merged, wo)
+	}
+	return merged
+}
+
+func (s *server) snapshot() []workorders.WorkOrder {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]workorders.WorkOrder, len(s.workOrders))
+	copy(out, s.workOrders)
+	return out
+}
+
+func (s *server) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /workorders", s.handleList)
+	mux.HandleFunc("GET /workorders/summary", s.handleSummary)
+	mux.HandleFunc("GET /workorders/{id}", s.handleGet)
+	return mux
+}
+
+// handleList serves GET /workorders?status=&facility=&projectNumber=,
+// filtering the in-memory snapshot.
+func (s *server) handleList(w http.ResponseWriter, r *http.Request) {
+	status := r.URL.Query().Get("status")
+	facility := r.URL.Query().Get("facility")
+	projectNumber := r.URL.Query().Get("projectNumber")
+
+	var matched []workorders.WorkOrder
+	for _, wo := range s.snapshot() {
+		if status != "" && wo.Status != status {
+			continue
+		}
+		if facility != "" && wo.Facility != facility {
+			continue
+		}
+		if projectNumber != "" && wo.ProjectNumber != projectNumber {
+			continue
+		}
+		matched = append(matched, wo)
+	}
+
+	writeJSON(w, http.StatusOK, matched)
+}
+
+// handleGet serves GET /workorders/{id}.
+func (s *server) handleGet(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	for _, wo := range s.snapshot() {
+		if wo.Id == id {
+			writeJSON(w, http.StatusOK, wo)
+			return
+		}
+	}
+	http.Error(w, "work order not found", http.StatusNotFound)
+}
+
+// summaryBucket is an aggregate count and total for one status or
+// facility bucket in the GET /workorders/summary response.
+type summaryBucket struct {
+	Count           int     `json:"count"`
+	GrandTotalPrice float64 `json:"grandTotalPrice"`
+}
+
+// handleSummary serves GET /workorders/summary, returning aggregate
+// counts and totals grouped by status and by facility.
+func (s *server) handleSummary(w http.ResponseWriter, r *http.Request) {
+	byStatus := map[string]*summaryBucket{}
+	byFacility := map[string]*summaryBucket{}
+
+	for _, wo := range s.snapshot() {
+		addToBucket(byStatus, wo.Status, wo.GrandTotalPrice.Value)
+		addToBucket(byFacility, wo.Facility, wo.GrandTotalPrice.Value)
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"byStatus":   byStatus,
+		"byFacility": byFacility,
+	})
+}
+
+func addToBucket(buckets map[string]*summaryBucket, key string, amount float64) {
+	b, ok := buckets[key]
+	if !ok {
+		b = &summaryBucket{}
+		buckets[key] = b
+	}
+	b.Count++
+	b.GrandTotalPrice += amount
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
@@ -1,134 +1,51 @@
 /*
 Work Orders API Fetch Example
 
-Build:
-  go build -o bin/work_orders GoLang/work_orders.go
+Build (from GoLang/, where go.mod lives):
+  cd GoLang && go build -o bin/work_orders .
+  go build ./...   # builds/checks every package in the module
 
 Run:
   ./bin/work_orders -env-path=.env
+  ./bin/work_orders -env-path=.env -status=Active -facility="Main Plant"
+  ./bin/work_orders -env-path=.env -state=.work_orders_sync.json
+  ./bin/work_orders -env-path=.env -call-timeout=10s
+  ./bin/work_orders -env-path=.env -format=csv -output=work_orders.csv
+  ./bin/work_orders -env-path=.env -format=parquet -output=work_orders.parquet
+  ./bin/work_orders -env-path=.env -cache=work_orders.db
+  ./bin/work_orders -cache=work_orders.db -query=open-by-facility
+  ./bin/work_orders -env-path=.env -serve=:8080 -refresh=5m
+  ./bin/work_orders -env-path=.env -fields=Id,Number,ProjectManager.FullName,"CustomFields[Name=PO Number].Value"
+
+The fetch logic lives in innergy/workorders, so it can be imported as a
+library by longer-running services instead of invoked as a one-shot
+binary; this file is a thin CLI wrapper around it.
 */
 
 package main
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"flag"
 	"fmt"
-	"io"
-	"net/http"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"strings"
+	"syscall"
 	"time"
-)
-
-// Person represents a user reference in the API response
-type Person struct {
-	Id       string `json:"Id"`
-	FullName string `json:"FullName"`
-}
-
-// MoneyValue represents a monetary amount
-type MoneyValue struct {
-	Value         float64 `json:"Value"`
-	OriginalValue float64 `json:"OriginalValue"`
-	CurrencyCode  string  `json:"CurrencyCode"`
-}
-
-// Margin represents margin data with cash and percentage
-type Margin struct {
-	Cash       MoneyValue `json:"Cash"`
-	Percentage float64    `json:"Percentage"`
-}
-
-// CustomField represents a custom field entry
-type CustomField struct {
-	Name  string `json:"Name"`
-	Type  int    `json:"Type"`
-	Value string `json:"Value"`
-}
-
-// Finish represents a finish option
-type Finish struct {
-	Id     string `json:"Id"`
-	Name   string `json:"Name"`
-	Code   string `json:"Code"`
-	Number string `json:"Number"`
-}
-
-// WorkOrder represents a work order from the API
-type WorkOrder struct {
-	Id                    string        `json:"Id"`
-	Number                string        `json:"Number"`
-	Name                  string        `json:"Name"`
-	Type                  string        `json:"Type"`
-	CreatedBy             Person        `json:"CreatedBy"`
-	CreatedOn             string        `json:"CreatedOn"`
-	Facility              string        `json:"Facility"`
-	Outsourced            bool          `json:"Outsourced"`
-	Tags                  []string      `json:"Tags"`
-	Status                string        `json:"Status"`
-	MaterialOnHandDays    int           `json:"MaterialOnHandDays"`
-	Step                  string        `json:"Step"`
-	StepIndex             int           `json:"StepIndex"`
-	StepType              string        `json:"StepType"`
-	InvoiceStatus         string        `json:"InvoiceStatus"`
-	Owner                 Person        `json:"Owner"`
-	Assignees             []Person      `json:"Assignees"`
-	Drafters              []Person      `json:"Drafters"`
-	Engineers             []Person      `json:"Engineers"`
-	Estimators            []Person      `json:"Estimators"`
-	SalesPersons          []Person      `json:"SalesPersons"`
-	Coordinators          []Person      `json:"Coordinators"`
-	Installers            []Person      `json:"Installers"`
-	ProjectManager        Person        `json:"ProjectManager"`
-	PlannedStartDate      string        `json:"PlannedStartDate"`
-	ActualStartDate       string        `json:"ActualStartDate"`
-	PlannedCriticalDate   string        `json:"PlannedCriticalDate"`
-	MaterialNeededDate    string        `json:"MaterialNeededDate"`
-	PlannedEndMonth       string        `json:"PlannedEndMonth"`
-	ActualEndDate         string        `json:"ActualEndDate"`
-	ActualEndMonth        string        `json:"ActualEndMonth"`
-	Instructions          string        `json:"Instructions"`
-	EstimatedLaborCost    MoneyValue    `json:"EstimatedLaborCost"`
-	EstimatedMaterialCost MoneyValue    `json:"EstimatedMaterialCost"`
-	EstimatedCost         MoneyValue    `json:"EstimatedCost"`
-	EstimatedHours        string        `json:"EstimatedHours"`
-	EstimatedMargin       Margin        `json:"EstimatedMargin"`
-	RemainingHours        string        `json:"RemainingHours"`
-	PlannedHours          string        `json:"PlannedHours"`
-	PlannedLaborCost      MoneyValue    `json:"PlannedLaborCost"`
-	LaborGrandTotalPrice  MoneyValue    `json:"LaborGrandTotalPrice"`
-	ActualLaborHours      string        `json:"ActualLaborHours"`
-	ActualCost            MoneyValue    `json:"ActualCost"`
-	ActualMaterialCost    MoneyValue    `json:"ActualMaterialCost"`
-	ActualLaborCost       MoneyValue    `json:"ActualLaborCost"`
-	ActualExpensesCost    MoneyValue    `json:"ActualExpensesCost"`
-	ActualMargin          Margin        `json:"ActualMargin"`
-	MarginVariance        MoneyValue    `json:"MarginVariance"`
-	GrandTotalPrice       MoneyValue    `json:"GrandTotalPrice"`
-	PreSalesTaxPrice      MoneyValue    `json:"PreSalesTaxPrice"`
-	SalesTax              MoneyValue    `json:"SalesTax"`
-	ExternalIdentifier    string        `json:"ExternalIdentifier"`
-	WorkflowName          string        `json:"WorkflowName"`
-	ProjectNumber         string        `json:"ProjectNumber"`
-	ProjectName           string        `json:"ProjectName"`
-	CustomFields          []CustomField `json:"CustomFields"`
-	Finishes              []Finish      `json:"Finishes"`
-}
 
-// APIResponse represents the API response structure
-type APIResponse struct {
-	Items []WorkOrder `json:"Items"`
-}
+	"github.com/Revive-Home-Brands/Innergy-API-examples/golang/innergy/workorders"
+)
 
 // Response represents the output structure
 type Response struct {
-	Success    bool        `json:"success"`
-	WorkOrders []WorkOrder `json:"workOrders"`
-	Count      int         `json:"count"`
-	Message    string      `json:"message,omitempty"`
+	Success    bool                   `json:"success"`
+	WorkOrders []workorders.WorkOrder `json:"workOrders"`
+	Count      int                    `json:"count"`
+	Message    string                 `json:"message,omitempty"`
 }
 
 // loadEnvFile reads a .env file and returns a map of key-value pairs.
@@ -158,108 +75,182 @@ func loadEnvFile(filepath string) (map[string]string, error) {
 	return env, scanner.Err()
 }
 
-/*
-fetchWorkOrders makes an HTTP GET request to the Innergy API.
+// fetchWorkOrders fetches every work order matching opts from the
+// Innergy API, draining the client's streaming FetchAll into a slice.
+// ctx governs the whole fetch, so callers can cancel or time it out.
+func fetchWorkOrders(ctx context.Context, apiKey string, opts workorders.Options) ([]workorders.WorkOrder, error) {
+	client := workorders.NewClient(apiKey)
 
- 1. Creates an HTTP client with a 120 second timeout
- 2. Builds a GET request to the projectWorkOrders endpoint
- 3. Sets the Accept header to application/json
- 4. Sets the Api-Key header for authentication
- 5. Executes the request and checks the response status
- 6. Returns the raw response body as bytes
-*/
-func fetchWorkOrders(apiKey string) ([]byte, error) {
-	client := &http.Client{Timeout: 120 * time.Second}
+	items, errc := client.FetchAll(ctx, opts)
 
-	req, err := http.NewRequest("GET", "https://app.innergy.com/api/projectWorkOrders", nil)
-	if err != nil {
-		return nil, err
+	var results []workorders.WorkOrder
+	for wo := range items {
+		results = append(results, wo)
 	}
-
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Api-Key", apiKey)
-
-	resp, err := client.Do(req)
-	if err != nil {
+	if err := <-errc; err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API returned status %d", resp.StatusCode)
-	}
-
-	return io.ReadAll(resp.Body)
+	return results, nil
 }
 
 /*
 main is the entry point of the program.
 
 How it works:
- 1. Parses command line flags to get the .env file path
+ 1. Parses command line flags to get the .env file path and fetch options
  2. Loads environment variables from the .env file
  3. Retrieves the API_KEY from the environment
- 4. Calls fetchWorkOrders to get data from the API
- 5. Unmarshals the JSON response into Go structs
- 6. Outputs the result as formatted JSON to stdout
- 7. Handles errors at each step and outputs error JSON if needed
+ 4. Calls fetchWorkOrders to page through the API with the given filters
+ 5. Outputs the result as formatted JSON to stdout
+ 6. Handles errors at each step and outputs error JSON if needed
 */
 func main() {
 	envPath := flag.String("env-path", "../.env", "Path to .env file")
+	status := flag.String("status", "", "Filter by work order status")
+	facility := flag.String("facility", "", "Filter by facility")
+	updatedAfter := flag.String("updated-after", "", "Only fetch work orders modified after this RFC3339 time")
+	statePath := flag.String("state", "", "Path to a JSON file used to persist the incremental sync cursor")
+	callTimeout := flag.Duration("call-timeout", 0, "Per-page request deadline, independent of the client's 120s timeout (0 disables)")
+	format := flag.String("format", "json", "Output format: json, ndjson, csv, or parquet")
+	outputPath := flag.String("output", "", "Output file path (defaults to stdout; required for parquet)")
+	listSeparator := flag.String("list-separator", ";", "Separator used to join slice fields (Tags, Assignees, ...) in CSV output")
+	cachePath := flag.String("cache", "", "Path to a SQLite database to upsert fetched work orders into")
+	query := flag.String("query", "", "Report to run against -cache instead of fetching: open-by-facility, margin-variance=<N>, or missing-material-date")
+	serveAddr := flag.String("serve", "", "Serve a local REST API on this address (e.g. :8080) instead of a single fetch")
+	refresh := flag.Duration("refresh", 5*time.Minute, "How often -serve refreshes its cache from the Innergy API")
+	fields := flag.String("fields", "", "Comma-separated field paths to project, e.g. Id,Number,ProjectManager.FullName,CustomFields[Name=PO Number].Value")
 	flag.Parse()
 
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if *query != "" {
+		if *cachePath == "" {
+			printError("-query requires -cache=<path.db>")
+			return
+		}
+		if err := runQuery(ctx, *cachePath, *query); err != nil {
+			printError(err.Error())
+		}
+		return
+	}
+
 	normalizedEnvPath := filepath.Clean(*envPath)
 
 	env, err := loadEnvFile(normalizedEnvPath)
 	if err != nil {
-		response := Response{
-			Success: false,
-			Message: fmt.Sprintf("Failed to load .env file: %v", err),
-		}
-		output, _ := json.MarshalIndent(response, "", "  ")
-		fmt.Println(string(output))
+		printError(fmt.Sprintf("Failed to load .env file: %v", err))
 		return
 	}
 
 	apiKey := env["API_KEY"]
 	if apiKey == "" {
-		response := Response{
-			Success: false,
-			Message: "API_KEY not found in .env file",
+		printError("API_KEY not found in .env file")
+		return
+	}
+
+	opts := workorders.Options{
+		Status:      *status,
+		Facility:    *facility,
+		StatePath:   *statePath,
+		CallTimeout: *callTimeout,
+	}
+	if *updatedAfter != "" {
+		parsed, err := time.Parse(time.RFC3339, *updatedAfter)
+		if err != nil {
+			printError(fmt.Sprintf("Invalid -updated-after value: %v", err))
+			return
+		}
+		opts.UpdatedAfter = parsed
+	}
+
+	if *serveAddr != "" {
+		if err := runServe(ctx, apiKey, opts, *serveAddr, *refresh); err != nil {
+			printError(fmt.Sprintf("Server exited: %v", err))
 		}
-		output, _ := json.MarshalIndent(response, "", "  ")
-		fmt.Println(string(output))
 		return
 	}
 
-	data, err := fetchWorkOrders(apiKey)
+	workOrders, err := fetchWorkOrders(ctx, apiKey, opts)
 	if err != nil {
-		response := Response{
-			Success: false,
-			Message: fmt.Sprintf("Failed to fetch work orders: %v", err),
+		printError(fmt.Sprintf("Failed to fetch work orders: %v", err))
+		return
+	}
+
+	if *cachePath != "" {
+		if err := cacheWorkOrders(ctx, *cachePath, workOrders); err != nil {
+			printError(fmt.Sprintf("Failed to write -cache: %v", err))
+			return
+		}
+	}
+
+	if *fields != "" {
+		projected, err := projectFields(workOrders, *fields)
+		if err != nil {
+			printError(fmt.Sprintf("Failed to project -fields: %v", err))
+			return
+		}
+		output, _ := json.MarshalIndent(projected, "", "  ")
+		if err := writeToOutput(*outputPath, output); err != nil {
+			printError(fmt.Sprintf("Failed to write output: %v", err))
 		}
-		output, _ := json.MarshalIndent(response, "", "  ")
-		fmt.Println(string(output))
 		return
 	}
 
-	var apiResponse APIResponse
-	if err := json.Unmarshal(data, &apiResponse); err != nil {
+	if *format == "" || *format == "json" {
 		response := Response{
-			Success: false,
-			Message: fmt.Sprintf("Failed to parse response: %v", err),
+			Success:    true,
+			WorkOrders: workOrders,
+			Count:      len(workOrders),
 		}
 		output, _ := json.MarshalIndent(response, "", "  ")
-		fmt.Println(string(output))
+		if err := writeToOutput(*outputPath, output); err != nil {
+			printError(fmt.Sprintf("Failed to write output: %v", err))
+		}
 		return
 	}
 
-	response := Response{
-		Success:    true,
-		WorkOrders: apiResponse.Items,
-		Count:      len(apiResponse.Items),
+	wr, err := newWriter(*format, *listSeparator, *outputPath)
+	if err != nil {
+		printError(err.Error())
+		return
 	}
 
+	if *format == "parquet" {
+		if err := wr.Write(nil, workOrders); err != nil {
+			printError(fmt.Sprintf("Failed to write output: %v", err))
+		}
+		return
+	}
+
+	dest := os.Stdout
+	if *outputPath != "" {
+		f, err := os.Create(*outputPath)
+		if err != nil {
+			printError(fmt.Sprintf("Failed to create -output file: %v", err))
+			return
+		}
+		defer f.Close()
+		dest = f
+	}
+
+	if err := wr.Write(dest, workOrders); err != nil {
+		printError(fmt.Sprintf("Failed to write output: %v", err))
+	}
+}
+
+// writeToOutput writes data followed by a newline to path, or to stdout
+// when path is empty.
+func writeToOutput(path string, data []byte) error {
+	if path == "" {
+		fmt.Println(string(data))
+		return nil
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+func printError(message string) {
+	response := Response{Success: false, Message: message}
 	output, _ := json.MarshalIndent(response, "", "  ")
 	fmt.Println(string(output))
 }